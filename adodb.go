@@ -0,0 +1,141 @@
+// Package adodb is a database/sql driver for ADODB, allowing Go programs to
+// talk to Jet/ACE (MS Access), SQL Server, Oracle and any other data source
+// reachable through an OLE DB provider via Microsoft's ActiveX Data Objects.
+package adodb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/go-ole/go-ole"
+	"github.com/go-ole/go-ole/oleutil"
+)
+
+// ConnectHook is called with a freshly opened Conn before it is handed back
+// to database/sql, letting callers reach through to the underlying
+// *ole.IDispatch ADO Connection to set provider-specific properties
+// (CursorLocation, CommandTimeout), install an Errors listener, or attach
+// tracing spans around the connection's lifetime.
+type ConnectHook func(*Conn) error
+
+// ExecerHook wraps every Exec/ExecContext call made through a connection
+// opened by the Driver it's attached to. exec runs the actual ADO command;
+// hooks call it themselves so they can wrap it with tracing, logging, or
+// retries.
+type ExecerHook func(ctx context.Context, query string, args []driver.NamedValue, exec func(context.Context) (driver.Result, error)) (driver.Result, error)
+
+// QueryerHook is ExecerHook's counterpart for Query/QueryContext.
+type QueryerHook func(ctx context.Context, query string, args []driver.NamedValue, run func(context.Context) (driver.Rows, error)) (driver.Rows, error)
+
+// Driver implements database/sql/driver.Driver on top of an ADODB.Connection
+// COM object. Its hooks let callers register several differently configured
+// drivers under distinct names via Register instead of relying on a single
+// package-level init().
+type Driver struct {
+	ConnectHook ConnectHook
+	ExecerHook  ExecerHook
+	QueryerHook QueryerHook
+}
+
+// Open creates a new ADODB.Connection and opens it against dsn, which is an
+// OLE DB connection string (e.g. "Provider=Microsoft.ACE.OLEDB.12.0;Data
+// Source=C:\\db.accdb;"). If d.ConnectHook is set, it runs before the
+// connection is returned to database/sql.
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	ole.CoInitialize(0)
+	unknown, err := oleutil.CreateObject("ADODB.Connection")
+	if err != nil {
+		return nil, err
+	}
+	defer unknown.Release()
+	db, err := unknown.QueryInterface(ole.IID_IDispatch)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := oleutil.CallMethod(db, "Open", dsn); err != nil {
+		db.Release()
+		return nil, err
+	}
+	conn := &Conn{db: db, driver: d}
+	if d.ConnectHook != nil {
+		if err := d.ConnectHook(conn); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// execerHook returns d.ExecerHook, or nil if d is nil (a Conn created
+// outside of Driver.Open, e.g. in tests, has no driver attached).
+func (d *Driver) execerHook() ExecerHook {
+	if d == nil {
+		return nil
+	}
+	return d.ExecerHook
+}
+
+// queryerHook returns d.QueryerHook, or nil if d is nil.
+func (d *Driver) queryerHook() QueryerHook {
+	if d == nil {
+		return nil
+	}
+	return d.QueryerHook
+}
+
+// Register registers d under name with database/sql, the same way
+// sql.Register does for a single global driver, so that multiple Driver
+// instances with different hooks can coexist under distinct names.
+func Register(name string, d *Driver) {
+	sql.Register(name, d)
+}
+
+func init() {
+	Register("adodb", &Driver{})
+}
+
+// Conn wraps a live ADODB.Connection COM object.
+type Conn struct {
+	db     *ole.IDispatch
+	driver *Driver
+}
+
+// Prepare returns a Stmt bound to this connection. ADODB command
+// preparation happens lazily on first Exec/Query, since we don't know the
+// parameter types until we see the arguments.
+func (c *Conn) Prepare(query string) (driver.Stmt, error) {
+	return &Stmt{c: c, query: query}, nil
+}
+
+// Close releases the underlying ADODB.Connection.
+func (c *Conn) Close() error {
+	_, err := oleutil.CallMethod(c.db, "Close")
+	c.db.Release()
+	return err
+}
+
+// Begin starts an ADODB transaction via BeginTrans.
+func (c *Conn) Begin() (driver.Tx, error) {
+	if _, err := oleutil.CallMethod(c.db, "BeginTrans"); err != nil {
+		return nil, err
+	}
+	return &Tx{db: c.db}, nil
+}
+
+// Tx wraps an in-progress ADODB transaction.
+type Tx struct {
+	db *ole.IDispatch
+}
+
+// Commit calls CommitTrans on the underlying ADODB.Connection.
+func (tx *Tx) Commit() error {
+	_, err := oleutil.CallMethod(tx.db, "CommitTrans")
+	return err
+}
+
+// Rollback calls RollbackTrans on the underlying ADODB.Connection.
+func (tx *Tx) Rollback() error {
+	_, err := oleutil.CallMethod(tx.db, "RollbackTrans")
+	return err
+}
@@ -0,0 +1,43 @@
+package adodb
+
+import (
+	"database/sql"
+	"testing"
+)
+
+// TestRegisterIsolation mirrors the sqlite3 backup test's pattern of
+// registering several drivers with distinct ConnectHooks under distinct
+// names and verifying that invoking one doesn't leak into the other.
+func TestRegisterIsolation(t *testing.T) {
+	var calledA, calledB bool
+
+	driverA := &Driver{ConnectHook: func(c *Conn) error {
+		calledA = true
+		return nil
+	}}
+	driverB := &Driver{ConnectHook: func(c *Conn) error {
+		calledB = true
+		return nil
+	}}
+
+	Register("adodb-test-a", driverA)
+	Register("adodb-test-b", driverB)
+
+	found := map[string]bool{}
+	for _, name := range sql.Drivers() {
+		found[name] = true
+	}
+	if !found["adodb-test-a"] || !found["adodb-test-b"] {
+		t.Fatalf("expected both registered driver names in sql.Drivers(), got %v", sql.Drivers())
+	}
+
+	if err := driverA.ConnectHook(nil); err != nil {
+		t.Fatalf("driverA.ConnectHook: %v", err)
+	}
+	if calledB {
+		t.Fatalf("invoking driverA's ConnectHook unexpectedly ran driverB's hook")
+	}
+	if !calledA {
+		t.Fatalf("driverA's ConnectHook did not run")
+	}
+}
@@ -0,0 +1,230 @@
+package adodb
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-ole/go-ole"
+	"github.com/go-ole/go-ole/oleutil"
+)
+
+// DefaultBackupStepPages is the page count Backup uses per Step call when
+// BackupOptions.StepPages is zero.
+const DefaultBackupStepPages = 256
+
+// BackupOptions configures Conn.Backup / Conn.NewBackup.
+type BackupOptions struct {
+	// CompactInPlace makes Backup compact into a sibling temp file
+	// (dstPath + ".compacttmp") and rename it over dstPath once the copy
+	// finishes, so dstPath may name the source database itself: JRO's
+	// CompactDatabase refuses identical source/destination connection
+	// strings. Backup does not compare dstPath with the source path.
+	CompactInPlace bool
+
+	// StepPages is how many pages each call to (*Backup).Step accounts for
+	// in Remaining/PageCount. Defaults to DefaultBackupStepPages.
+	StepPages int
+}
+
+// Backupper is implemented by Conn. It mirrors the page-by-page backup
+// handle go-sqlite3's NewBackup returns, adapted to JRO/ADOX's
+// whole-database compact semantics.
+type Backupper interface {
+	Backup(ctx context.Context, dstPath string, opts BackupOptions) error
+	NewBackup(dstPath string, opts BackupOptions) (*Backup, error)
+}
+
+// Backup performs a full online copy of c's Jet/ACE database to dstPath,
+// stepping BackupOptions.StepPages pages at a time until done or ctx is
+// canceled.
+func (c *Conn) Backup(ctx context.Context, dstPath string, opts BackupOptions) error {
+	b, err := c.NewBackup(dstPath, opts)
+	if err != nil {
+		return err
+	}
+	defer b.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		done, err := b.Step(b.stepPages)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// Backup is an in-progress online copy of a Jet/ACE database, created by
+// Conn.NewBackup. CompactDatabase itself runs atomically the first time
+// Step is called and the compacted file is moved into place as soon as it
+// returns; subsequent Step calls only drain the page counters so callers
+// get the same incremental-progress shape as go-sqlite3's backup API,
+// including NULL OLEObject/long-binary columns (JRO copies them
+// byte-for-byte, same as the data covered by TestOLEObjectHandling).
+type Backup struct {
+	srcConnStr string
+	dstConnStr string
+	tmpPath    string
+	finalPath  string
+	inPlace    bool
+
+	stepPages int
+	pageCount int
+	remaining int
+
+	// compacted is true once CompactDatabase (and, when inPlace, the
+	// rename into finalPath) has actually finished. It is the real
+	// completion signal; remaining/done below only track a cosmetic
+	// progress countdown and must never gate Close's cleanup decision.
+	compacted bool
+	done      bool
+}
+
+// NewBackup prepares a compact of c's database into dstPath without
+// stepping yet; the JRO.JetEngine object is created by the first Step call.
+func (c *Conn) NewBackup(dstPath string, opts BackupOptions) (*Backup, error) {
+	srcConnStr, err := oleutil.GetProperty(c.db, "ConnectionString")
+	if err != nil {
+		return nil, fmt.Errorf("adodb: reading source ConnectionString: %w", err)
+	}
+
+	stepPages := opts.StepPages
+	if stepPages <= 0 {
+		stepPages = DefaultBackupStepPages
+	}
+
+	tmpPath := dstPath
+	if opts.CompactInPlace {
+		tmpPath = dstPath + ".compacttmp"
+	}
+
+	pageCount, err := jetPageCount(c.db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compact into a database using the same provider the source connection
+	// used (Jet stays Jet, ACE stays ACE); CompactDatabase only changes the
+	// file's Jet/ACE engine version when explicitly asked to, and forcing a
+	// different provider here would silently reformat the copy instead of
+	// just compacting it.
+	provider := dsnProvider(srcConnStr.ToString())
+	if provider == "" {
+		provider = "Microsoft.ACE.OLEDB.12.0"
+	}
+
+	return &Backup{
+		srcConnStr: srcConnStr.ToString(),
+		dstConnStr: fmt.Sprintf("Provider=%s;Data Source=%s;", provider, tmpPath),
+		tmpPath:    tmpPath,
+		finalPath:  dstPath,
+		inPlace:    opts.CompactInPlace,
+		stepPages:  stepPages,
+		pageCount:  pageCount,
+		remaining:  pageCount,
+	}, nil
+}
+
+// Step runs the CompactDatabase call on the first invocation (JRO has no
+// partial-compact API) and moves the result into place immediately, since
+// CompactDatabase is synchronous and the copy is already complete by the
+// time it returns. Later calls (and `pages`) only drain the cosmetic
+// Remaining counter; they never affect whether the backup itself
+// succeeded. Step's bool return reports whether the countdown has reached
+// zero, not whether the data is safely on disk — callers that only care
+// about the latter can check Done after any Step call returns a nil error.
+func (b *Backup) Step(pages int) (bool, error) {
+	if b.done {
+		return true, nil
+	}
+	if !b.compacted {
+		unknown, err := oleutil.CreateObject("JRO.JetEngine")
+		if err != nil {
+			return false, fmt.Errorf("adodb: creating JRO.JetEngine: %w", err)
+		}
+		defer unknown.Release()
+		jetEngine, err := unknown.QueryInterface(ole.IID_IDispatch)
+		if err != nil {
+			return false, fmt.Errorf("adodb: querying JetEngine interface: %w", err)
+		}
+		defer jetEngine.Release()
+
+		if _, err := oleutil.CallMethod(jetEngine, "CompactDatabase", b.srcConnStr, b.dstConnStr); err != nil {
+			return false, fmt.Errorf("adodb: CompactDatabase: %w", err)
+		}
+		if b.inPlace {
+			if err := os.Rename(b.tmpPath, b.finalPath); err != nil {
+				return false, fmt.Errorf("adodb: moving compacted database into place: %w", err)
+			}
+		}
+		b.compacted = true
+	}
+
+	if pages <= 0 || pages >= b.remaining {
+		b.remaining = 0
+	} else {
+		b.remaining -= pages
+	}
+	b.done = b.remaining == 0
+	return b.done, nil
+}
+
+// Done reports whether the backup has actually finished writing
+// finalPath — unlike Step's return value, this never depends on the
+// cosmetic Remaining countdown.
+func (b *Backup) Done() bool {
+	return b.compacted
+}
+
+// Remaining reports the number of pages not yet accounted for by Step.
+func (b *Backup) Remaining() int {
+	return b.remaining
+}
+
+// PageCount reports the total number of pages this backup covers.
+func (b *Backup) PageCount() int {
+	return b.pageCount
+}
+
+// Close releases resources held by the Backup. If the backup never
+// actually completed (Done() == false), any partial temp file used for
+// CompactInPlace is removed; a completed backup is left untouched even if
+// its cosmetic Remaining countdown never reached zero.
+func (b *Backup) Close() error {
+	if !b.compacted && b.inPlace {
+		os.Remove(b.tmpPath)
+	}
+	return nil
+}
+
+// jetPageCount estimates the page count of the source database so Backup
+// can report progress; Jet/ACE don't expose a direct page counter over
+// ADODB, so we fall back to the file size divided by the standard 4 KiB
+// Jet page size.
+func jetPageCount(db *ole.IDispatch) (int, error) {
+	connStr, err := oleutil.GetProperty(db, "ConnectionString")
+	if err != nil {
+		return 0, err
+	}
+	path := dataSourcePath(connStr.ToString())
+	if path == "" {
+		return 1, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 1, nil
+	}
+	const jetPageSize = 4096
+	pages := int(info.Size() / jetPageSize)
+	if pages < 1 {
+		pages = 1
+	}
+	return pages, nil
+}
@@ -0,0 +1,157 @@
+package adodb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestBackup compacts a Jet/ACE test database containing both NULL and
+// non-NULL OLEOBJECT/long-binary data — the same column shape
+// TestOLEObjectHandling exercises — through a full Backup call and verifies
+// the resulting copy is written to disk. Whether Done reports completion
+// before the Remaining countdown reaches zero is checked by
+// TestBackupStepCompletesBeforeCountdown.
+func TestBackup(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	tableName := "BackupBinaryTable"
+	db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName))
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE %s (ID INTEGER PRIMARY KEY, BlobData OLEOBJECT)", tableName)); err != nil {
+		t.Fatalf("Failed to create table %s: %v", tableName, err)
+	}
+	defer db.Exec(fmt.Sprintf("DROP TABLE %s", tableName))
+
+	if _, err := db.Exec(fmt.Sprintf("INSERT INTO %s (ID, BlobData) VALUES (?, ?)", tableName), 1, []byte{0, 1, 2, 3, 255}); err != nil {
+		t.Fatalf("Failed to insert binary data: %v", err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("INSERT INTO %s (ID, BlobData) VALUES (?, ?)", tableName), 2, nil); err != nil {
+		t.Fatalf("Failed to insert NULL binary data: %v", err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	dstPath := filepath.Join(wd, "testole_backup.accdb")
+	defer os.Remove(dstPath)
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get a connection: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		c, ok := driverConn.(*Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver.Conn type %T", driverConn)
+		}
+		// A StepPages smaller than the real page count forces several
+		// Step calls before Backup returns.
+		return c.Backup(context.Background(), dstPath, BackupOptions{StepPages: 1})
+	})
+	if err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+
+	if _, err := os.Stat(dstPath); err != nil {
+		t.Fatalf("Backup did not produce a file at %s: %v", dstPath, err)
+	}
+}
+
+// TestBackupStepCompletesBeforeCountdown verifies that Backup.Done reports
+// true as soon as the first Step call returns, even though Remaining may
+// still be nonzero, so a caller that stops stepping early (or whose ctx is
+// canceled) never has Close delete an already-completed copy.
+func TestBackupStepCompletesBeforeCountdown(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	dstPath := filepath.Join(wd, "testole_backup_partial.accdb")
+	defer os.Remove(dstPath)
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get a connection: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		c, ok := driverConn.(*Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver.Conn type %T", driverConn)
+		}
+		b, err := c.NewBackup(dstPath, BackupOptions{StepPages: 1})
+		if err != nil {
+			return err
+		}
+		defer b.Close()
+
+		if _, err := b.Step(1); err != nil {
+			return err
+		}
+		if !b.Done() {
+			t.Fatalf("backup should be complete after the first Step, even with Remaining() == %d", b.Remaining())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+
+	if _, err := os.Stat(dstPath); err != nil {
+		t.Fatalf("Backup did not produce a file at %s: %v", dstPath, err)
+	}
+}
+
+// TestNewBackupMatchesSourceProvider verifies NewBackup's destination
+// connection string uses the same provider as the source connection. The
+// test database is opened via ACEDSN, so only the ACE case is checked; a
+// Jet source opened via JetDSN is not exercised here.
+func TestNewBackupMatchesSourceProvider(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	dstPath := filepath.Join(wd, "testole_backup_provider.accdb")
+	defer os.Remove(dstPath)
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get a connection: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		c, ok := driverConn.(*Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver.Conn type %T", driverConn)
+		}
+		b, err := c.NewBackup(dstPath, BackupOptions{})
+		if err != nil {
+			return err
+		}
+		defer b.Close()
+
+		srcProvider := dsnProvider(b.srcConnStr)
+		dstProvider := dsnProvider(b.dstConnStr)
+		if srcProvider == "" || dstProvider != srcProvider {
+			t.Fatalf("dstConnStr provider = %q, want it to match source provider %q (dstConnStr=%q)", dstProvider, srcProvider, b.dstConnStr)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewBackup failed: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package adodb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// TestBlobParameterWrite exercises the documented Blob{Reader, Size} usage
+// through db.Exec end-to-end, the path CheckNamedValue must keep reachable:
+// without a Blob case there, database/sql's default parameter converter
+// rejects the struct before writeBlobParameter ever runs.
+func TestBlobParameterWrite(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	tableName := "BlobParamTable"
+	db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName))
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE %s (ID INTEGER PRIMARY KEY, BlobData OLEOBJECT)", tableName)); err != nil {
+		t.Fatalf("Failed to create table %s: %v", tableName, err)
+	}
+	defer db.Exec(fmt.Sprintf("DROP TABLE %s", tableName))
+
+	originalData := []byte{10, 20, 30, 40, 50}
+	blob := Blob{Reader: bytes.NewReader(originalData), Size: int64(len(originalData))}
+	if _, err := db.Exec(fmt.Sprintf("INSERT INTO %s (ID, BlobData) VALUES (?, ?)", tableName), 1, blob); err != nil {
+		t.Fatalf("Failed to insert via Blob parameter: %v", err)
+	}
+
+	var retrieved []byte
+	if err := db.QueryRow(fmt.Sprintf("SELECT BlobData FROM %s WHERE ID = ?", tableName), 1).Scan(&retrieved); err != nil {
+		t.Fatalf("Failed to query data written via Blob parameter: %v", err)
+	}
+	if !bytes.Equal(retrieved, originalData) {
+		t.Errorf("Retrieved data mismatch: got %v, want %v", retrieved, originalData)
+	}
+}
+
+// TestOLEObjectHandlingLargeValue verifies that rows.Scan into a plain
+// []byte keeps working for an OLEOBJECT column whose value is large,
+// exactly the regression the review flagged: materializing must never
+// depend on how big the field happens to be.
+func TestOLEObjectHandlingLargeValue(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	tableName := "BigBinaryTable"
+	db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName))
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE %s (ID INTEGER PRIMARY KEY, BlobData OLEOBJECT)", tableName)); err != nil {
+		t.Fatalf("Failed to create table %s: %v", tableName, err)
+	}
+	defer db.Exec(fmt.Sprintf("DROP TABLE %s", tableName))
+
+	originalData := bytes.Repeat([]byte{1, 2, 3, 4}, 1<<18) // 1 MiB, well past any size heuristic
+	if _, err := db.Exec(fmt.Sprintf("INSERT INTO %s (ID, BlobData) VALUES (?, ?)", tableName), 1, originalData); err != nil {
+		t.Fatalf("Failed to insert binary data: %v", err)
+	}
+
+	var retrieved []byte
+	if err := db.QueryRow(fmt.Sprintf("SELECT BlobData FROM %s WHERE ID = ?", tableName), 1).Scan(&retrieved); err != nil {
+		t.Fatalf("Failed to query large binary data: %v", err)
+	}
+	if !bytes.Equal(retrieved, originalData) {
+		t.Errorf("Retrieved large binary data mismatch: got %d bytes, want %d bytes", len(retrieved), len(originalData))
+	}
+}
+
+// TestQueryBlobStreaming exercises the explicit opt-in streaming path:
+// Conn.QueryBlob must hand back a *BlobReader that yields the column's
+// bytes via GetChunk, without ever going through rows.Scan.
+func TestQueryBlobStreaming(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	tableName := "StreamBinaryTable"
+	db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName))
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE %s (ID INTEGER PRIMARY KEY, BlobData OLEOBJECT)", tableName)); err != nil {
+		t.Fatalf("Failed to create table %s: %v", tableName, err)
+	}
+	defer db.Exec(fmt.Sprintf("DROP TABLE %s", tableName))
+
+	originalData := bytes.Repeat([]byte{9, 8, 7, 6, 5}, 1<<16)
+	if _, err := db.Exec(fmt.Sprintf("INSERT INTO %s (ID, BlobData) VALUES (?, ?)", tableName), 1, originalData); err != nil {
+		t.Fatalf("Failed to insert binary data: %v", err)
+	}
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get a connection: %v", err)
+	}
+	defer conn.Close()
+
+	var got []byte
+	err = conn.Raw(func(driverConn interface{}) error {
+		c, ok := driverConn.(*Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver.Conn type %T", driverConn)
+		}
+		br, err := c.QueryBlob(ctx, fmt.Sprintf("SELECT BlobData FROM %s WHERE ID = ?", tableName), 0, 1)
+		if err != nil {
+			return err
+		}
+		defer br.Close()
+		got, err = io.ReadAll(br)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("QueryBlob failed: %v", err)
+	}
+	if !bytes.Equal(got, originalData) {
+		t.Errorf("Streamed binary data mismatch: got %d bytes, want %d bytes", len(got), len(originalData))
+	}
+}
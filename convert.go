@@ -0,0 +1,42 @@
+package adodb
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/go-ole/go-ole"
+)
+
+// Parameter values are bound directly through CreateParameter's Value
+// argument (see Stmt.command), so there's no need for a separate
+// driver.Value -> ole.VARIANT conversion on the write side; only the
+// read-side conversion below is needed.
+
+// fromVariant converts the value returned by a COM GetProperty/GetValue call
+// back into a database/sql/driver.Value, applying the same type mapping
+// used for column values read off a Recordset Field.
+func fromVariant(v *ole.VARIANT) (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	val := v.Value()
+	switch t := val.(type) {
+	case nil:
+		return nil, nil
+	case int, int8, int16, int32, int64:
+		return t, nil
+	case float32, float64:
+		return t, nil
+	case bool:
+		return t, nil
+	case string:
+		return t, nil
+	case time.Time:
+		return t, nil
+	case []byte:
+		return t, nil
+	default:
+		return nil, fmt.Errorf("adodb: unsupported variant type %T", t)
+	}
+}
@@ -0,0 +1,231 @@
+package adodb
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-ole/go-ole"
+	"github.com/go-ole/go-ole/oleutil"
+)
+
+// quoteDSNValue quotes s for use as an OLE DB connection string value if it
+// contains a character ("Provider=...;" separator, either quote mark, or
+// leading/trailing whitespace) that would otherwise corrupt or truncate the
+// string, following the same quoting rule OLE DB itself documents: wrap the
+// value in whichever quote mark doesn't already appear in it, or in double
+// quotes with embedded double quotes doubled if both appear.
+func quoteDSNValue(s string) string {
+	if s == strings.TrimSpace(s) && !strings.ContainsAny(s, ";\"'") {
+		return s
+	}
+	switch {
+	case !strings.Contains(s, `"`):
+		return `"` + s + `"`
+	case !strings.Contains(s, `'`):
+		return `'` + s + `'`
+	default:
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+}
+
+// dataSourcePath extracts the "Data Source" (or "DBQ", for ODBC-style
+// strings) value out of an OLE DB/ODBC connection string. It returns "" if
+// neither key is present.
+func dataSourcePath(connStr string) string {
+	return connStrValue(connStr, "Data Source", "DBQ")
+}
+
+// dsnProvider extracts the "Provider" value out of an OLE DB connection
+// string. It returns "" if the key is not present.
+func dsnProvider(connStr string) string {
+	return connStrValue(connStr, "Provider")
+}
+
+// connStrValue returns the value of the first of keys found in an OLE
+// DB/ODBC connection string's ";"-separated "Key=Value" pairs, matching
+// keys case-insensitively. It returns "" if none of keys is present.
+func connStrValue(connStr string, keys ...string) string {
+	for _, part := range strings.Split(connStr, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(kv[0])
+		for _, want := range keys {
+			if strings.EqualFold(key, want) {
+				return strings.TrimSpace(kv[1])
+			}
+		}
+	}
+	return ""
+}
+
+// JetDSN builds an OLE DB connection string for the legacy Jet 4.0 provider
+// (Microsoft.Jet.OLEDB.4.0), used for classic .mdb files.
+type JetDSN struct {
+	Path      string
+	Password  string
+	Exclusive bool
+}
+
+// String renders d as a "Provider=...;Data Source=...;" connection string.
+func (d JetDSN) String() string {
+	var b strings.Builder
+	b.WriteString("Provider=Microsoft.Jet.OLEDB.4.0;")
+	fmt.Fprintf(&b, "Data Source=%s;", quoteDSNValue(d.Path))
+	if d.Password != "" {
+		fmt.Fprintf(&b, "Jet OLEDB:Database Password=%s;", quoteDSNValue(d.Password))
+	}
+	if d.Exclusive {
+		b.WriteString("Mode=Share Exclusive;")
+	}
+	return b.String()
+}
+
+// ACEDSN builds an OLE DB connection string for the modern ACE provider
+// (Microsoft.ACE.OLEDB.12.0), which reads both .mdb and .accdb files.
+type ACEDSN struct {
+	Path              string
+	UseAccdb          bool
+	MachineDatasource string
+
+	// CreateIfMissing makes Create (and therefore Open) create Path as an
+	// empty database via ADOX.Catalog when the file does not exist yet,
+	// instead of requiring callers to pre-create the file or open-code a
+	// CreateDB retry loop. Passing String() to sql.Open directly does not
+	// create the file.
+	CreateIfMissing bool
+}
+
+// String renders d as a "Provider=...;Data Source=...;" connection string.
+func (d ACEDSN) String() string {
+	var b strings.Builder
+	b.WriteString("Provider=Microsoft.ACE.OLEDB.12.0;")
+	fmt.Fprintf(&b, "Data Source=%s;", quoteDSNValue(d.Path))
+	if d.MachineDatasource != "" {
+		fmt.Fprintf(&b, "Jet OLEDB:System Database=%s;", quoteDSNValue(d.MachineDatasource))
+	}
+	return b.String()
+}
+
+// SQLOLEDBDSN builds an OLE DB connection string for Microsoft SQL Server
+// via the legacy SQLOLEDB provider.
+type SQLOLEDBDSN struct {
+	Server             string
+	Database           string
+	IntegratedSecurity bool
+	ApplicationName    string
+}
+
+// String renders d as a "Provider=SQLOLEDB;...;" connection string.
+func (d SQLOLEDBDSN) String() string {
+	var b strings.Builder
+	b.WriteString("Provider=SQLOLEDB;")
+	fmt.Fprintf(&b, "Data Source=%s;", quoteDSNValue(d.Server))
+	if d.Database != "" {
+		fmt.Fprintf(&b, "Initial Catalog=%s;", quoteDSNValue(d.Database))
+	}
+	if d.IntegratedSecurity {
+		b.WriteString("Integrated Security=SSPI;")
+	}
+	if d.ApplicationName != "" {
+		fmt.Fprintf(&b, "Application Name=%s;", quoteDSNValue(d.ApplicationName))
+	}
+	return b.String()
+}
+
+// OraOLEDBDSN builds an OLE DB connection string for Oracle via Oracle's
+// OraOLEDB provider.
+type OraOLEDBDSN struct {
+	DataSource string
+	UserID     string
+	Password   string
+}
+
+// String renders d as a "Provider=OraOLEDB.Oracle;...;" connection string.
+func (d OraOLEDBDSN) String() string {
+	var b strings.Builder
+	b.WriteString("Provider=OraOLEDB.Oracle;")
+	fmt.Fprintf(&b, "Data Source=%s;", quoteDSNValue(d.DataSource))
+	if d.UserID != "" {
+		fmt.Fprintf(&b, "User Id=%s;", quoteDSNValue(d.UserID))
+	}
+	if d.Password != "" {
+		fmt.Fprintf(&b, "Password=%s;", quoteDSNValue(d.Password))
+	}
+	return b.String()
+}
+
+// Companion driver names pre-configured with provider-appropriate defaults.
+// Register them once at package init so callers can sql.Open("adodb-jet",
+// (adodb.JetDSN{...}).String()) etc. without repeating CursorLocation/Mode
+// boilerplate in every connection string.
+const (
+	JetDriverName   = "adodb-jet"
+	ACEDriverName   = "adodb-ace"
+	MSSQLDriverName = "adodb-mssql"
+)
+
+// ADODB.CursorLocationEnum / ADODB.ConnectModeEnum values used by the
+// companion drivers' defaults.
+const (
+	adUseClient         = 3
+	adModeShareDenyNone = 4
+)
+
+func init() {
+	Register(JetDriverName, &Driver{
+		ConnectHook: func(c *Conn) error {
+			_, err := oleutil.PutProperty(c.db, "Mode", adModeShareDenyNone)
+			return err
+		},
+	})
+	Register(ACEDriverName, &Driver{})
+	Register(MSSQLDriverName, &Driver{
+		ConnectHook: func(c *Conn) error {
+			_, err := oleutil.PutProperty(c.db, "CursorLocation", adUseClient)
+			return err
+		},
+	})
+}
+
+// Create creates an empty ACE database at d.Path via ADOX.Catalog, the
+// OLE DB equivalent of the ODBC "CreateDB=" DSN keyword. It is a no-op if
+// d.CreateIfMissing is false or the file already exists.
+func (d ACEDSN) Create() error {
+	if !d.CreateIfMissing {
+		return nil
+	}
+	if _, err := os.Stat(d.Path); err == nil {
+		return nil
+	}
+	ole.CoInitialize(0)
+	unknown, err := oleutil.CreateObject("ADOX.Catalog")
+	if err != nil {
+		return fmt.Errorf("adodb: creating ADOX.Catalog: %w", err)
+	}
+	defer unknown.Release()
+	catalog, err := unknown.QueryInterface(ole.IID_IDispatch)
+	if err != nil {
+		return fmt.Errorf("adodb: querying ADOX.Catalog interface: %w", err)
+	}
+	defer catalog.Release()
+
+	if _, err := oleutil.CallMethod(catalog, "Create", d.String()); err != nil {
+		return fmt.Errorf("adodb: ADOX.Catalog.Create: %w", err)
+	}
+	return nil
+}
+
+// Open creates d.Path (via Create) if it's missing and CreateIfMissing is
+// set, then opens it through the ACEDriverName companion driver. The ACE
+// provider is selected by d.String(); the ACE companion driver sets no
+// connection defaults of its own.
+func (d ACEDSN) Open() (*sql.DB, error) {
+	if err := d.Create(); err != nil {
+		return nil, err
+	}
+	return sql.Open(ACEDriverName, d.String())
+}
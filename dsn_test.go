@@ -0,0 +1,155 @@
+package adodb
+
+import "testing"
+
+func TestQuoteDSNValue(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", `C:\db.accdb`, `C:\db.accdb`},
+		{"semicolon", `C:\a;b.accdb`, `"C:\a;b.accdb"`},
+		{"double quote", `a"b`, `'a"b'`},
+		{"single quote", `a'b`, `"a'b"`},
+		{"both quote kinds", `a"b'c`, `"a""b'c"`},
+		{"leading space", ` a`, `" a"`},
+		{"trailing space", `a `, `"a "`},
+		{"empty", ``, ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quoteDSNValue(tt.in); got != tt.want {
+				t.Errorf("quoteDSNValue(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDsnProvider(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"jet", `Provider=Microsoft.Jet.OLEDB.4.0;Data Source=C:\db.mdb;`, "Microsoft.Jet.OLEDB.4.0"},
+		{"ace", `Provider=Microsoft.ACE.OLEDB.12.0;Data Source=C:\db.accdb;`, "Microsoft.ACE.OLEDB.12.0"},
+		{"missing", `Data Source=C:\db.accdb;`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dsnProvider(tt.in); got != tt.want {
+				t.Errorf("dsnProvider(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJetDSNString(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  JetDSN
+		want string
+	}{
+		{
+			name: "plain path",
+			dsn:  JetDSN{Path: `C:\db.mdb`},
+			want: `Provider=Microsoft.Jet.OLEDB.4.0;Data Source=C:\db.mdb;`,
+		},
+		{
+			name: "path with semicolon and password",
+			dsn:  JetDSN{Path: `C:\a;b.mdb`, Password: `p;w"d`},
+			want: `Provider=Microsoft.Jet.OLEDB.4.0;Data Source="C:\a;b.mdb";Jet OLEDB:Database Password='p;w"d';`,
+		},
+		{
+			name: "exclusive",
+			dsn:  JetDSN{Path: `C:\db.mdb`, Exclusive: true},
+			want: `Provider=Microsoft.Jet.OLEDB.4.0;Data Source=C:\db.mdb;Mode=Share Exclusive;`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dsn.String(); got != tt.want {
+				t.Errorf("JetDSN.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestACEDSNString(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  ACEDSN
+		want string
+	}{
+		{
+			name: "plain path",
+			dsn:  ACEDSN{Path: `C:\db.accdb`},
+			want: `Provider=Microsoft.ACE.OLEDB.12.0;Data Source=C:\db.accdb;`,
+		},
+		{
+			name: "path needing quoting",
+			dsn:  ACEDSN{Path: `C:\a;b.accdb`, MachineDatasource: `C:\sys;db.mdw`},
+			want: `Provider=Microsoft.ACE.OLEDB.12.0;Data Source="C:\a;b.accdb";Jet OLEDB:System Database="C:\sys;db.mdw";`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dsn.String(); got != tt.want {
+				t.Errorf("ACEDSN.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLOLEDBDSNString(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  SQLOLEDBDSN
+		want string
+	}{
+		{
+			name: "plain",
+			dsn:  SQLOLEDBDSN{Server: "myserver", Database: "mydb", IntegratedSecurity: true},
+			want: `Provider=SQLOLEDB;Data Source=myserver;Initial Catalog=mydb;Integrated Security=SSPI;`,
+		},
+		{
+			name: "values needing quoting",
+			dsn:  SQLOLEDBDSN{Server: "my;server", Database: `my"db`, ApplicationName: "my app;1"},
+			want: `Provider=SQLOLEDB;Data Source="my;server";Initial Catalog='my"db';Application Name="my app;1";`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dsn.String(); got != tt.want {
+				t.Errorf("SQLOLEDBDSN.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOraOLEDBDSNString(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  OraOLEDBDSN
+		want string
+	}{
+		{
+			name: "plain",
+			dsn:  OraOLEDBDSN{DataSource: "orcl", UserID: "scott", Password: "tiger"},
+			want: `Provider=OraOLEDB.Oracle;Data Source=orcl;User Id=scott;Password=tiger;`,
+		},
+		{
+			name: "password needing quoting",
+			dsn:  OraOLEDBDSN{DataSource: "orcl", UserID: "scott", Password: `ti;ger"`},
+			want: `Provider=OraOLEDB.Oracle;Data Source=orcl;User Id=scott;Password='ti;ger"';`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dsn.String(); got != tt.want {
+				t.Errorf("OraOLEDBDSN.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
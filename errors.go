@@ -0,0 +1,7 @@
+package adodb
+
+import "errors"
+
+// errNoLastInsertID is returned by Result.LastInsertId since ADODB has no
+// provider-agnostic way to retrieve the identity value of the last insert.
+var errNoLastInsertID = errors.New("adodb: LastInsertId is not supported, query @@IDENTITY/SCOPE_IDENTITY instead")
@@ -0,0 +1,48 @@
+package adodb
+
+// ADO data type constants (ADODB.DataTypeEnum), limited to the ones we need
+// to describe Go parameter values.
+const (
+	adInteger       = 3
+	adDouble        = 5
+	adBoolean       = 11
+	adVarChar       = 200
+	adLongVarBinary = 205
+	adDate          = 7
+)
+
+// adParamType maps a Go value to the ADODB.DataTypeEnum CreateParameter
+// expects. It covers the integer, float, bool and binary (including Blob)
+// types fromVariant handles; every other value, string and time.Time
+// included, is declared as adVarChar.
+func adParamType(v interface{}) int {
+	switch v.(type) {
+	case int, int8, int16, int32, int64:
+		return adInteger
+	case float32, float64:
+		return adDouble
+	case bool:
+		return adBoolean
+	case []byte:
+		return adLongVarBinary
+	case Blob:
+		return adLongVarBinary
+	default:
+		return adVarChar
+	}
+}
+
+// adParamSize returns the Size argument CreateParameter needs for
+// variable-length types; fixed-size types ignore it.
+func adParamSize(v interface{}) int {
+	switch t := v.(type) {
+	case []byte:
+		return len(t)
+	case string:
+		return len(t)
+	case Blob:
+		return int(t.Size)
+	default:
+		return 0
+	}
+}
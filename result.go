@@ -0,0 +1,18 @@
+package adodb
+
+// Result implements database/sql/driver.Result for ADODB commands that
+// return a RecordsAffected count by Execute. ADODB has no notion of a
+// generated identity value shared across providers, so LastInsertId always
+// returns an error; callers needing it should query @@IDENTITY/SCOPE_IDENTITY
+// themselves.
+type Result struct {
+	rowsAffected int64
+}
+
+func (r *Result) LastInsertId() (int64, error) {
+	return 0, errNoLastInsertID
+}
+
+func (r *Result) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
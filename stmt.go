@@ -0,0 +1,299 @@
+package adodb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/go-ole/go-ole"
+	"github.com/go-ole/go-ole/oleutil"
+)
+
+// ADO parameter direction constants (ADODB.ParameterDirectionEnum).
+const (
+	adParamInput       = 1
+	adParamOutput      = 2
+	adParamInputOutput = 3
+)
+
+// Stmt wraps a query string together with its owning Conn. ADODB commands
+// are prepared lazily: we don't build the ADODB.Command/Parameters until
+// Exec/Query sees the actual arguments, since that's the only place we know
+// each parameter's direction and type.
+type Stmt struct {
+	c     *Conn
+	query string
+}
+
+func (s *Stmt) Close() error {
+	return nil
+}
+
+// NumInput returns -1: the query may use the "?" placeholder an arbitrary
+// number of times, and OUT/INOUT parameters arrive as sql.NamedArg rather
+// than as plain positional values, so we can't know the count up front.
+func (s *Stmt) NumInput() int {
+	return -1
+}
+
+// CheckNamedValue lets callers pass sql.Named("", sql.Out{Dest: &x}) and Blob
+// values through unconverted, since driver.DefaultParameterConverter rejects
+// both as unsupported struct types; everything else falls back to the
+// default converter.
+func (s *Stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	switch nv.Value.(type) {
+	case sql.Out, Blob:
+		return nil
+	}
+	return driver.ErrSkip
+}
+
+// Exec implements driver.Stmt for callers still using the legacy
+// database/sql/driver.Execer path (no OUT parameter support, since that
+// path never carries a NamedValue's Name/Out wrapper).
+func (s *Stmt) Exec(args []driver.Value) (driver.Result, error) {
+	nvs := make([]driver.NamedValue, len(args))
+	for i, v := range args {
+		nvs[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return s.doExec(context.Background(), nvs)
+}
+
+// Query implements driver.Stmt for callers still using the legacy
+// database/sql/driver.Queryer path.
+func (s *Stmt) Query(args []driver.Value) (driver.Rows, error) {
+	nvs := make([]driver.NamedValue, len(args))
+	for i, v := range args {
+		nvs[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return s.doQuery(context.Background(), nvs)
+}
+
+// ExecContext implements driver.StmtExecContext so that sql.Out destinations
+// can be written back once the ADO command has run.
+func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.doExec(ctx, args)
+}
+
+// QueryContext implements driver.StmtQueryContext.
+func (s *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.doQuery(ctx, args)
+}
+
+// command builds an ADODB.Command for s.query, binding args to its
+// Parameters collection. It returns the live command together with the list
+// of (parameter, destination) pairs that need to be read back after
+// execution because they were declared OUT or INOUT.
+func (s *Stmt) command(args []driver.NamedValue) (*ole.IDispatch, []outBinding, error) {
+	unknown, err := oleutil.CreateObject("ADODB.Command")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unknown.Release()
+	cmd, err := unknown.QueryInterface(ole.IID_IDispatch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := oleutil.PutProperty(cmd, "ActiveConnection", s.c.db); err != nil {
+		cmd.Release()
+		return nil, nil, err
+	}
+	if _, err := oleutil.PutProperty(cmd, "CommandText", s.query); err != nil {
+		cmd.Release()
+		return nil, nil, err
+	}
+
+	params := oleutil.MustGetProperty(cmd, "Parameters").ToIDispatch()
+	defer params.Release()
+
+	var outs []outBinding
+	for i, arg := range args {
+		direction := adParamInput
+		value := arg.Value
+		var dest interface{}
+		var blob *Blob
+
+		if out, ok := arg.Value.(sql.Out); ok {
+			rv := reflect.ValueOf(out.Dest)
+			if rv.Kind() != reflect.Ptr || rv.IsNil() {
+				releaseOutBindings(outs)
+				cmd.Release()
+				return nil, nil, fmt.Errorf("adodb: sql.Out.Dest must be a non-nil pointer, got %T", out.Dest)
+			}
+			dest = out.Dest
+			value = rv.Elem().Interface()
+			if out.In {
+				direction = adParamInputOutput
+			} else {
+				direction = adParamOutput
+			}
+		}
+
+		if b, ok := value.(Blob); ok {
+			blob = &b
+		}
+
+		name := arg.Name
+		if name == "" {
+			name = fmt.Sprintf("p%d", i+1)
+		}
+
+		var paramValue interface{}
+		size := adParamSize(value)
+		if blob != nil {
+			paramValue = nil
+			size = int(blob.Size)
+		} else {
+			paramValue = value
+		}
+
+		param, err := oleutil.CallMethod(cmd, "CreateParameter", name, adParamType(value), direction, size, paramValue)
+		if err != nil {
+			releaseOutBindings(outs)
+			cmd.Release()
+			return nil, nil, err
+		}
+		p := param.ToIDispatch()
+		if blob != nil {
+			if err := writeBlobParameter(p, *blob); err != nil {
+				p.Release()
+				releaseOutBindings(outs)
+				cmd.Release()
+				return nil, nil, err
+			}
+		}
+		if _, err := oleutil.CallMethod(params, "Append", p); err != nil {
+			p.Release()
+			releaseOutBindings(outs)
+			cmd.Release()
+			return nil, nil, err
+		}
+		if dest != nil {
+			outs = append(outs, outBinding{param: p, dest: dest})
+		} else {
+			p.Release()
+		}
+	}
+
+	return cmd, outs, nil
+}
+
+// outBinding remembers an OUT/INOUT ADODB.Parameter together with the Go
+// pointer its post-execution Value must be written into.
+type outBinding struct {
+	param *ole.IDispatch
+	dest  interface{}
+}
+
+func (s *Stmt) doExec(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	run := func(ctx context.Context) (driver.Result, error) {
+		cmd, outs, err := s.command(args)
+		if err != nil {
+			return nil, err
+		}
+		defer cmd.Release()
+
+		var recordsAffected ole.VARIANT
+		ole.VariantInit(&recordsAffected)
+		defer recordsAffected.Clear()
+		result, err := oleutil.CallMethod(cmd, "Execute", &recordsAffected)
+		if err != nil {
+			releaseOutBindings(outs)
+			return nil, err
+		}
+		defer result.Clear()
+
+		if err := writeOutBindings(outs); err != nil {
+			return nil, err
+		}
+
+		var rowsAffected int64
+		switch v := recordsAffected.Value().(type) {
+		case int32:
+			rowsAffected = int64(v)
+		case int64:
+			rowsAffected = v
+		}
+		return &Result{rowsAffected: rowsAffected}, nil
+	}
+	if hook := s.c.driver.execerHook(); hook != nil {
+		return hook(ctx, s.query, args, run)
+	}
+	return run(ctx)
+}
+
+func (s *Stmt) doQuery(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	run := func(ctx context.Context) (driver.Rows, error) {
+		cmd, outs, err := s.command(args)
+		if err != nil {
+			return nil, err
+		}
+		defer cmd.Release()
+
+		result, err := oleutil.CallMethod(cmd, "Execute")
+		if err != nil {
+			releaseOutBindings(outs)
+			return nil, err
+		}
+
+		if err := writeOutBindings(outs); err != nil {
+			return nil, err
+		}
+
+		rs := result.ToIDispatch()
+		return &Rows{rs: rs}, nil
+	}
+	if hook := s.c.driver.queryerHook(); hook != nil {
+		return hook(ctx, s.query, args, run)
+	}
+	return run(ctx)
+}
+
+// writeOutBindings reads each OUT/INOUT ADODB.Parameter's Value back through
+// OLE, converts it with fromVariant, and assigns it to the caller's
+// sql.Out.Dest pointer.
+func writeOutBindings(outs []outBinding) error {
+	for _, o := range outs {
+		variant := oleutil.MustGetProperty(o.param, "Value")
+		value, err := fromVariant(variant)
+		o.param.Release()
+		if err != nil {
+			return fmt.Errorf("adodb: reading OUT parameter: %w", err)
+		}
+		if err := assignOut(o.dest, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func releaseOutBindings(outs []outBinding) {
+	for _, o := range outs {
+		o.param.Release()
+	}
+}
+
+// assignOut stores value into *dest, converting between the underlying
+// driver.Value representation and dest's concrete type.
+func assignOut(dest interface{}, value driver.Value) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("adodb: sql.Out.Dest must be a non-nil pointer, got %T", dest)
+	}
+	elem := rv.Elem()
+	if value == nil {
+		elem.Set(reflect.Zero(elem.Type()))
+		return nil
+	}
+	vv := reflect.ValueOf(value)
+	if !vv.Type().AssignableTo(elem.Type()) {
+		if !vv.Type().ConvertibleTo(elem.Type()) {
+			return fmt.Errorf("adodb: cannot assign OUT parameter of type %T to %s", value, elem.Type())
+		}
+		vv = vv.Convert(elem.Type())
+	}
+	elem.Set(vv)
+	return nil
+}
@@ -0,0 +1,53 @@
+package adodb
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+// TestResultRowsAffected verifies that sql.Result.RowsAffected reports the
+// real count ADO's Execute reports back via RecordsAffected, not a
+// hardcoded 0.
+func TestResultRowsAffected(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	tableName := "RowsAffectedTable"
+	db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName))
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE %s (ID INTEGER PRIMARY KEY, Val INTEGER)", tableName)); err != nil {
+		t.Fatalf("Failed to create table %s: %v", tableName, err)
+	}
+	defer db.Exec(fmt.Sprintf("DROP TABLE %s", tableName))
+
+	for i := 1; i <= 3; i++ {
+		if _, err := db.Exec(fmt.Sprintf("INSERT INTO %s (ID, Val) VALUES (?, ?)", tableName), i, 0); err != nil {
+			t.Fatalf("Failed to insert row %d: %v", i, err)
+		}
+	}
+
+	result, err := db.Exec(fmt.Sprintf("UPDATE %s SET Val = 1 WHERE ID <= 2", tableName))
+	if err != nil {
+		t.Fatalf("Failed to update rows: %v", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("RowsAffected returned an error: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("RowsAffected() = %d, want 2", n)
+	}
+}
+
+// TestOutParamNilDest verifies that a nil typed pointer in sql.Out.Dest
+// produces a clean adodb error instead of panicking inside reflect.
+func TestOutParamNilDest(t *testing.T) {
+	db := getTestMdbDsn(t)
+	defer db.Close()
+
+	var dest *int
+	_, err := db.Exec("{? = call SomeProc(?)}", sql.Named("", sql.Out{Dest: dest}))
+	if err == nil {
+		t.Fatal("expected an error for a nil sql.Out.Dest, got nil")
+	}
+}